@@ -1,4 +1,255 @@
 package collections
 
-//The Map type is specialized. Most code should use a plain Go map instead, with separate locking or coordination, for better type safety and to make it easier to maintain other invariants along with the map content.
-//The Map type is optimized for two common use cases: (1) when the entry for a given key is only ever written once but read many times, as in caches that only grow, or (2) when multiple goroutines read, write, and overwrite entries for disjoint sets of keys. In these two cases, use of a Map may significantly reduce lock contention compared to a Go map paired with a separate Mutex or RWMutex.
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ConcurrentSet is a concurrent-safe counterpart to Set, guarded by a
+// sync.RWMutex around the same underlying map. Use it instead of Set
+// whenever the Set is shared across goroutines; both implement Setter.
+type ConcurrentSet[T comparable] struct {
+	mu sync.RWMutex
+	m  map[T]struct{}
+}
+
+// NewConcurrentSet returns a new ConcurrentSet.
+// You must include a comparable (hashable) type in the type parameter.
+// Example: NewConcurrentSet[int]()
+func NewConcurrentSet[T comparable]() *ConcurrentSet[T] {
+	return &ConcurrentSet[T]{m: make(map[T]struct{})}
+}
+
+// Add adds an element to the Set.
+// Returns the Set itself for chaining purposes.
+func (s *ConcurrentSet[T]) Add(element T) Setter[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m[element] = struct{}{}
+	return s
+}
+
+// Has returns true if the element is in the Set.
+func (s *ConcurrentSet[T]) Has(element T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.m[element]
+	return ok
+}
+
+// Remove removes an element from the Set.
+// Returns an error if the element is not in the Set.
+func (s *ConcurrentSet[T]) Remove(element T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.m[element]; !ok {
+		return errors.New(fmt.Sprintf("element %v is not in the Set", element))
+	}
+	delete(s.m, element)
+	return nil
+}
+
+// Size gets the size of the Set.
+func (s *ConcurrentSet[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.m)
+}
+
+// ToSlice returns a slice of all elements in the Set.
+// This is NOT ordered as the Set does not guarantee order.
+func (s *ConcurrentSet[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	slice := make([]T, 0, len(s.m))
+	for key := range s.m {
+		slice = append(slice, key)
+	}
+	return slice
+}
+
+// Copy copies the Set to a new ConcurrentSet.
+func (s *ConcurrentSet[T]) Copy() Setter[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	newSet := NewConcurrentSet[T]()
+	for key := range s.m {
+		newSet.m[key] = struct{}{}
+	}
+	return newSet
+}
+
+// Clear removes all elements in the Set.
+// Returns the Set itself for chaining purposes.
+func (s *ConcurrentSet[T]) Clear() Setter[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m = make(map[T]struct{})
+	return s
+}
+
+// IsSubSetOf returns true if the Set is a subSet of the other Set.
+func (s *ConcurrentSet[T]) IsSubSetOf(other Setter[T]) bool {
+	elements := s.ToSlice()
+	if len(elements) == 0 {
+		return true
+	}
+	if other == nil {
+		return false
+	}
+
+	for _, key := range elements {
+		if !other.Has(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuperSetOf returns true if the Set is a super Set of the other Set.
+func (s *ConcurrentSet[T]) IsSuperSetOf(other Setter[T]) bool {
+	if other == nil || other.Size() == 0 {
+		return true
+	}
+
+	for _, key := range other.ToSlice() {
+		if !s.Has(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Union merges other into s, returning s for chaining.
+//
+// If other is also a *ConcurrentSet, both sets are locked together via
+// lockPair instead of one after the other, so a concurrent a.Union(b)
+// racing against b.Union(a) can't deadlock. For any other Setter
+// implementation, other's elements are snapshotted with ToSlice (which
+// takes and releases other's own lock) before s is locked for writing.
+func (s *ConcurrentSet[T]) Union(other Setter[T]) Setter[T] {
+	if cs, ok := other.(*ConcurrentSet[T]); ok {
+		unlock := lockPair(s, cs)
+		defer unlock()
+		for key := range cs.m {
+			s.m[key] = struct{}{}
+		}
+		return s
+	}
+
+	if other == nil || other.Size() == 0 {
+		return s
+	}
+	elements := other.ToSlice()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range elements {
+		s.m[key] = struct{}{}
+	}
+	return s
+}
+
+// Intersect intersects s with other, returning s for chaining.
+//
+// It locks two *ConcurrentSet arguments via lockPair for the same
+// deadlock-avoidance reason as Union; any other Setter is snapshotted
+// with ToSlice before s is locked for writing.
+func (s *ConcurrentSet[T]) Intersect(other Setter[T]) Setter[T] {
+	if cs, ok := other.(*ConcurrentSet[T]); ok {
+		unlock := lockPair(s, cs)
+		defer unlock()
+		for key := range s.m {
+			if _, ok := cs.m[key]; !ok {
+				delete(s.m, key)
+			}
+		}
+		return s
+	}
+
+	if other == nil || other.Size() == 0 {
+		return s
+	}
+	keep := make(map[T]struct{})
+	for _, key := range other.ToSlice() {
+		keep[key] = struct{}{}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.m {
+		if _, ok := keep[key]; !ok {
+			delete(s.m, key)
+		}
+	}
+	return s
+}
+
+// Diff removes all the elements in s that the other Set has too, returning
+// s for chaining.
+//
+// It locks two *ConcurrentSet arguments via lockPair for the same
+// deadlock-avoidance reason as Union; any other Setter is snapshotted
+// with ToSlice before s is locked for writing.
+func (s *ConcurrentSet[T]) Diff(other Setter[T]) Setter[T] {
+	if other == nil {
+		return s
+	}
+
+	if cs, ok := other.(*ConcurrentSet[T]); ok {
+		unlock := lockPair(s, cs)
+		defer unlock()
+		for key := range cs.m {
+			delete(s.m, key)
+		}
+		return s
+	}
+
+	elements := other.ToSlice()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range elements {
+		delete(s.m, key)
+	}
+	return s
+}
+
+// lockPair locks s for writing and other for reading, always acquiring the
+// lower-addressed ConcurrentSet's lock first.
+//
+// This fixes a single, stable order across every pair of ConcurrentSets:
+// without it, a.Union(b) running concurrently with b.Union(a) would lock
+// (a then b) in one goroutine and (b then a) in the other, which is the
+// textbook two-lock deadlock. Ordering by address sidesteps that since
+// both goroutines agree on which set to lock first regardless of which
+// one is "s" and which is "other".
+func lockPair[T comparable](s, other *ConcurrentSet[T]) func() {
+	sAddr := reflect.ValueOf(s).Pointer()
+	otherAddr := reflect.ValueOf(other).Pointer()
+
+	if sAddr == otherAddr {
+		s.mu.Lock()
+		return s.mu.Unlock
+	}
+
+	if sAddr < otherAddr {
+		s.mu.Lock()
+		other.mu.RLock()
+		return func() {
+			other.mu.RUnlock()
+			s.mu.Unlock()
+		}
+	}
+
+	other.mu.RLock()
+	s.mu.Lock()
+	return func() {
+		s.mu.Unlock()
+		other.mu.RUnlock()
+	}
+}
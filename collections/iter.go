@@ -0,0 +1,123 @@
+package collections
+
+import (
+	"iter"
+	"sync"
+)
+
+// iterChanBuffer is the buffer size used for the channels returned by Iter.
+// It's kept small and fixed so Set's Iter never has to allocate or copy the
+// whole population up front the way ToSlice does. (ConcurrentSet's Iter
+// does take a ToSlice snapshot first; see its doc comment.)
+const iterChanBuffer = 16
+
+// Iter returns a channel that yields every element in the Set, and a stop
+// function. If you break out of the range over the channel before it's
+// drained, you must call stop so the background goroutine feeding the
+// channel doesn't leak.
+func (s Set[T]) Iter() (<-chan T, func()) {
+	return iterChan(func(yield func(T) bool) {
+		for key := range s {
+			if !yield(key) {
+				return
+			}
+		}
+	})
+}
+
+// All returns an iter.Seq[T] over every element in the Set, for use with
+// range-over-func: for v := range set.All() { ... }.
+func (s Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for key := range s {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Each calls f with every element in the Set, stopping early if f returns
+// false. It returns true if every element was visited.
+func (s Set[T]) Each(f func(T) bool) bool {
+	for key := range s {
+		if !f(key) {
+			return false
+		}
+	}
+	return true
+}
+
+// Iter returns a channel that yields every element in the Set, and a stop
+// function. If you break out of the range over the channel before it's
+// drained, you must call stop so the background goroutine feeding the
+// channel doesn't leak.
+//
+// The elements are snapshotted under a read lock before any are sent, so
+// the lock isn't held while the channel is being drained by caller code.
+func (s *ConcurrentSet[T]) Iter() (<-chan T, func()) {
+	keys := s.ToSlice()
+	return iterChan(func(yield func(T) bool) {
+		for _, key := range keys {
+			if !yield(key) {
+				return
+			}
+		}
+	})
+}
+
+// All returns an iter.Seq[T] over every element in the Set, for use with
+// range-over-func: for v := range set.All() { ... }.
+//
+// The elements are snapshotted under a read lock before the sequence yields
+// its first value, so the lock isn't held across caller code.
+func (s *ConcurrentSet[T]) All() iter.Seq[T] {
+	keys := s.ToSlice()
+	return func(yield func(T) bool) {
+		for _, key := range keys {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Each calls f with every element in the Set, stopping early if f returns
+// false. It returns true if every element was visited.
+//
+// The elements are snapshotted under a read lock before f is first called,
+// so the lock isn't held across caller code.
+func (s *ConcurrentSet[T]) Each(f func(T) bool) bool {
+	for _, key := range s.ToSlice() {
+		if !f(key) {
+			return false
+		}
+	}
+	return true
+}
+
+// iterChan drains seq into a buffered channel on a background goroutine and
+// returns it alongside a stop function. Calling stop signals the goroutine
+// to give up on sending further elements and return, so a caller that
+// breaks out of its range early doesn't leak the goroutine.
+func iterChan[T any](seq iter.Seq[T]) (<-chan T, func()) {
+	out := make(chan T, iterChanBuffer)
+	done := make(chan struct{})
+
+	var once sync.Once
+	stop := func() { once.Do(func() { close(done) }) }
+
+	go func() {
+		defer close(out)
+		seq(func(v T) bool {
+			select {
+			case out <- v:
+				return true
+			case <-done:
+				return false
+			}
+		})
+	}()
+
+	return out, stop
+}
@@ -0,0 +1,237 @@
+package collections
+
+import (
+	"fmt"
+	"sync"
+)
+
+// DefaultMapShards is the shard count NewMap uses; see NewMapWithShards.
+const DefaultMapShards = 32
+
+//The Map type is specialized. Most code should use a plain Go map instead, with separate locking or coordination, for better type safety and to make it easier to maintain other invariants along with the map content.
+//The Map type is optimized for two common use cases: (1) when the entry for a given key is only ever written once but read many times, as in caches that only grow, or (2) when multiple goroutines read, write, and overwrite entries for disjoint sets of keys. In these two cases, use of a Map may significantly reduce lock contention compared to a Go map paired with a separate Mutex or RWMutex.
+type Map[K comparable, V any] struct {
+	shards []*mapShard[K, V]
+}
+
+type mapShard[K comparable, V any] struct {
+	sync.RWMutex
+	m map[K]V
+}
+
+// NewMap returns a new Map with DefaultMapShards shards.
+// You must include a comparable (hashable) type in the K type parameter.
+// Example: NewMap[string, int]()
+func NewMap[K comparable, V any]() *Map[K, V] {
+	return NewMapWithShards[K, V](DefaultMapShards)
+}
+
+// NewMapWithShards returns a new Map with the given number of shards.
+// More shards reduce lock contention between goroutines touching disjoint
+// keys, at the cost of a little extra memory. Panics if shards <= 0.
+func NewMapWithShards[K comparable, V any](shards int) *Map[K, V] {
+	if shards <= 0 {
+		panic("collections: shards must be > 0")
+	}
+
+	m := &Map[K, V]{shards: make([]*mapShard[K, V], shards)}
+	for i := range m.shards {
+		m.shards[i] = &mapShard[K, V]{m: make(map[K]V)}
+	}
+	return m
+}
+
+// Load returns the value stored for key, if any.
+func (m *Map[K, V]) Load(key K) (V, bool) {
+	shard := m.shardFor(key)
+	shard.RLock()
+	defer shard.RUnlock()
+	v, ok := shard.m[key]
+	return v, ok
+}
+
+// Store sets the value for key.
+func (m *Map[K, V]) Store(key K, value V) {
+	shard := m.shardFor(key)
+	shard.Lock()
+	defer shard.Unlock()
+	shard.m[key] = value
+}
+
+// LoadOrStore returns the existing value for key if present. Otherwise, it
+// stores and returns value. The loaded result is true if the value was
+// already present.
+func (m *Map[K, V]) LoadOrStore(key K, value V) (V, bool) {
+	shard := m.shardFor(key)
+	shard.Lock()
+	defer shard.Unlock()
+
+	if existing, ok := shard.m[key]; ok {
+		return existing, true
+	}
+	shard.m[key] = value
+	return value, false
+}
+
+// LoadAndDelete deletes the value for key, returning the previous value if
+// any. The loaded result reports whether the key was present.
+func (m *Map[K, V]) LoadAndDelete(key K) (V, bool) {
+	shard := m.shardFor(key)
+	shard.Lock()
+	defer shard.Unlock()
+
+	v, ok := shard.m[key]
+	if ok {
+		delete(shard.m, key)
+	}
+	return v, ok
+}
+
+// Delete deletes the value for key.
+func (m *Map[K, V]) Delete(key K) {
+	shard := m.shardFor(key)
+	shard.Lock()
+	defer shard.Unlock()
+	delete(shard.m, key)
+}
+
+// CompareAndSwap stores new for key if the Map's current value for key is
+// equal to old, and returns whether the swap happened.
+//
+// As with sync.Map, the comparison is a plain ==, which panics at runtime
+// if V's underlying type isn't comparable.
+func (m *Map[K, V]) CompareAndSwap(key K, old, new V) bool {
+	shard := m.shardFor(key)
+	shard.Lock()
+	defer shard.Unlock()
+
+	existing, ok := shard.m[key]
+	if !ok || any(existing) != any(old) {
+		return false
+	}
+	shard.m[key] = new
+	return true
+}
+
+// CompareAndDelete deletes the value for key if the Map's current value for
+// key is equal to old, and returns whether the delete happened.
+//
+// As with sync.Map, the comparison is a plain ==, which panics at runtime
+// if V's underlying type isn't comparable.
+func (m *Map[K, V]) CompareAndDelete(key K, old V) bool {
+	shard := m.shardFor(key)
+	shard.Lock()
+	defer shard.Unlock()
+
+	existing, ok := shard.m[key]
+	if !ok || any(existing) != any(old) {
+		return false
+	}
+	delete(shard.m, key)
+	return true
+}
+
+// Range calls f for every key/value pair in the Map, in no particular
+// order, stopping early if f returns false.
+//
+// Range locks and scans one shard at a time rather than the whole Map, so
+// it is not a consistent point-in-time snapshot: a concurrent Store or
+// Delete may or may not be observed depending on whether it lands on a
+// shard Range has already passed.
+func (m *Map[K, V]) Range(f func(K, V) bool) {
+	for _, shard := range m.shards {
+		if !rangeShard(shard, f) {
+			return
+		}
+	}
+}
+
+// Len returns the number of entries in the Map, summed across shards under
+// their read locks. Like Range, this isn't a consistent snapshot under
+// concurrent writes.
+func (m *Map[K, V]) Len() int {
+	total := 0
+	for _, shard := range m.shards {
+		shard.RLock()
+		total += len(shard.m)
+		shard.RUnlock()
+	}
+	return total
+}
+
+// shardFor routes key to one of m's shards by hashing it with FNV-1a.
+func (m *Map[K, V]) shardFor(key K) *mapShard[K, V] {
+	return m.shards[hashKey(key)%uint64(len(m.shards))]
+}
+
+func rangeShard[K comparable, V any](shard *mapShard[K, V], f func(K, V) bool) bool {
+	shard.RLock()
+	defer shard.RUnlock()
+	for k, v := range shard.m {
+		if !f(k, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// FNV-1a constants, see https://en.wikipedia.org/wiki/Fowler%E2%80%93Noll%E2%80%93Vo_hash_function.
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// hashKey hashes key with FNV-1a, folding it in byte by byte without ever
+// allocating: strings are walked by index (no copy), fixed-size integer
+// types are folded in via bit shifts (no intermediate byte slice), and only
+// the fallback path for exotic key types pays for a fmt.Sprintf allocation.
+func hashKey[K comparable](key K) uint64 {
+	h := uint64(fnvOffset64)
+
+	switch v := any(key).(type) {
+	case string:
+		for i := 0; i < len(v); i++ {
+			h = fnv1aByte(h, v[i])
+		}
+	case int:
+		h = fnv1aUint64(h, uint64(v))
+	case int8:
+		h = fnv1aByte(h, byte(v))
+	case int16:
+		h = fnv1aUint64(h, uint64(uint16(v)))
+	case int32:
+		h = fnv1aUint64(h, uint64(uint32(v)))
+	case int64:
+		h = fnv1aUint64(h, uint64(v))
+	case uint:
+		h = fnv1aUint64(h, uint64(v))
+	case uint8:
+		h = fnv1aByte(h, v)
+	case uint16:
+		h = fnv1aUint64(h, uint64(v))
+	case uint32:
+		h = fnv1aUint64(h, uint64(v))
+	case uint64:
+		h = fnv1aUint64(h, v)
+	default:
+		s := fmt.Sprintf("%v", v)
+		for i := 0; i < len(s); i++ {
+			h = fnv1aByte(h, s[i])
+		}
+	}
+
+	return h
+}
+
+func fnv1aByte(h uint64, b byte) uint64 {
+	h ^= uint64(b)
+	h *= fnvPrime64
+	return h
+}
+
+func fnv1aUint64(h uint64, v uint64) uint64 {
+	for i := 0; i < 8; i++ {
+		h = fnv1aByte(h, byte(v>>(8*i)))
+	}
+	return h
+}
@@ -0,0 +1,121 @@
+package collections
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// BenchmarkMapSharedKeyHot compares collections.Map against sync.Map and a
+// plain map+sync.RWMutex under a 90% read / 10% write workload hammering a
+// single shared key — the worst case for a sharded map, since every
+// goroutine routes to the same shard and contends on the same lock.
+func BenchmarkMapSharedKeyHot(b *testing.B) {
+	b.Run("collections.Map", func(b *testing.B) {
+		m := NewMap[int, int]()
+		m.Store(0, 0)
+		runSharedKey(b, func(i int) {
+			if i%10 == 0 {
+				m.Store(0, i)
+			} else {
+				m.Load(0)
+			}
+		})
+	})
+
+	b.Run("sync.Map", func(b *testing.B) {
+		var m sync.Map
+		m.Store(0, 0)
+		runSharedKey(b, func(i int) {
+			if i%10 == 0 {
+				m.Store(0, i)
+			} else {
+				m.Load(0)
+			}
+		})
+	})
+
+	b.Run("map+RWMutex", func(b *testing.B) {
+		var mu sync.RWMutex
+		m := map[int]int{0: 0}
+		runSharedKey(b, func(i int) {
+			if i%10 == 0 {
+				mu.Lock()
+				m[0] = i
+				mu.Unlock()
+			} else {
+				mu.RLock()
+				_ = m[0]
+				mu.RUnlock()
+			}
+		})
+	})
+}
+
+// BenchmarkMapDisjointKeys compares the same three implementations under a
+// workload where every goroutine reads and writes its own private range of
+// keys — the case collections.Map is meant for, since distinct keys mostly
+// land on distinct shards and goroutines rarely contend on the same lock.
+func BenchmarkMapDisjointKeys(b *testing.B) {
+	b.Run("collections.Map", func(b *testing.B) {
+		m := NewMap[int, int]()
+		runDisjointKeys(b, func(key int) {
+			m.Store(key, key)
+			m.Load(key)
+		})
+	})
+
+	b.Run("sync.Map", func(b *testing.B) {
+		var m sync.Map
+		runDisjointKeys(b, func(key int) {
+			m.Store(key, key)
+			m.Load(key)
+		})
+	})
+
+	b.Run("map+RWMutex", func(b *testing.B) {
+		var mu sync.RWMutex
+		m := make(map[int]int)
+		runDisjointKeys(b, func(key int) {
+			mu.Lock()
+			m[key] = key
+			mu.Unlock()
+			mu.RLock()
+			_ = m[key]
+			mu.RUnlock()
+		})
+	})
+}
+
+// runSharedKey drives op with a monotonically increasing counter shared
+// across all goroutines, so each call sees a consistent 90/10 read/write
+// split regardless of how the runtime schedules the parallel workers.
+func runSharedKey(b *testing.B, op func(i int)) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var counter int64
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			op(int(atomic.AddInt64(&counter, 1)))
+		}
+	})
+}
+
+// runDisjointKeys drives op with a key range private to each goroutine, so
+// concurrent calls never touch the same key.
+func runDisjointKeys(b *testing.B, op func(key int)) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	const keysPerGoroutine = 1_000_000
+	var goroutineID int64
+	b.RunParallel(func(pb *testing.PB) {
+		base := int(atomic.AddInt64(&goroutineID, 1)) * keysPerGoroutine
+		i := 0
+		for pb.Next() {
+			op(base + i%keysPerGoroutine)
+			i++
+		}
+	})
+}
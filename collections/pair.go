@@ -0,0 +1,8 @@
+package collections
+
+// Pair holds two values of possibly different types, e.g. as the element
+// type of the Set returned by CartesianProduct.
+type Pair[T, U any] struct {
+	First  T
+	Second U
+}
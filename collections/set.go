@@ -9,6 +9,8 @@ import (
 type Set[T comparable] map[T]struct{}
 
 // NewSet returns a new Set. This Set is NOT concurrent safe.
+// If you need a concurrent-safe Set, use NewConcurrentSet instead; both
+// implement the Setter interface.
 // You must include a comparable (hashable) type in the type parameter.
 // Example: NewSet[int]()
 func NewSet[T comparable]() Set[T] {
@@ -17,7 +19,13 @@ func NewSet[T comparable]() Set[T] {
 
 // Add function simply adds an element to the Set.
 // Returns the Set itself for chaining purposes.
-func (s Set[T]) Add(element T) Set[T] {
+//
+// BREAKING CHANGE: this used to return Set[T]. It now returns Setter[T] so
+// Set satisfies the Setter interface alongside ConcurrentSet. Callers
+// chaining straight off the result as a concrete Set[T] (e.g. assigning it
+// back to a Set[T]-typed variable) need a type assertion, e.g.
+// s.Add(x).(Set[T]).
+func (s Set[T]) Add(element T) Setter[T] {
 	s[element] = struct{}{}
 	return s
 }
@@ -97,7 +105,10 @@ func (s Set[T]) MustRemoveAll(elements []T) Set[T] {
 
 // Clear removes all elements in the Set, might be useful in niche cases
 // Returns the Set itself for chaining purposes.
-func (s Set[T]) Clear() Set[T] {
+//
+// BREAKING CHANGE: this used to return Set[T]; see Add's doc comment for
+// why and how to migrate (s.Clear().(Set[T])).
+func (s Set[T]) Clear() Setter[T] {
 	for k := range s {
 		delete(s, k)
 	}
@@ -105,7 +116,10 @@ func (s Set[T]) Clear() Set[T] {
 }
 
 // Copy copies the Set to a new Set
-func (s Set[T]) Copy() Set[T] {
+//
+// BREAKING CHANGE: this used to return Set[T]; see Add's doc comment for
+// why and how to migrate (s.Copy().(Set[T])).
+func (s Set[T]) Copy() Setter[T] {
 	newSet := make(Set[T], len(s))
 
 	for key, value := range s {
@@ -132,12 +146,12 @@ func (s Set[T]) ToSlice() []T {
 }
 
 // IsSuperSetOf returns true if the Set is a super Set of the other Set
-func (s Set[T]) IsSuperSetOf(other Set[T]) bool {
+func (s Set[T]) IsSuperSetOf(other Setter[T]) bool {
 	if other == nil || other.Size() == 0 {
 		return true
 	}
 
-	for key := range other {
+	for _, key := range other.ToSlice() {
 		if !s.Has(key) {
 			return false
 		}
@@ -147,10 +161,13 @@ func (s Set[T]) IsSuperSetOf(other Set[T]) bool {
 }
 
 // IsSubSetOf returns true if the Set is a subSet of the other Set
-func (s Set[T]) IsSubSetOf(other Set[T]) bool {
+func (s Set[T]) IsSubSetOf(other Setter[T]) bool {
 	if s == nil || s.Size() == 0 {
 		return true
 	}
+	if other == nil {
+		return false
+	}
 
 	for key := range s {
 		if !other.Has(key) {
@@ -162,15 +179,21 @@ func (s Set[T]) IsSubSetOf(other Set[T]) bool {
 }
 
 // Diff removes all the elements in s that the other Set has too.
-func (s Set[T]) Diff(other Set[T]) Set[T] {
-	for key := range other {
+func (s Set[T]) Diff(other Setter[T]) Setter[T] {
+	if other == nil {
+		return s
+	}
+	for _, key := range other.ToSlice() {
 		_ = s.Remove(key)
 	}
 	return s
 }
 
 // NewDiff returns a new Set with all the elements in s that the other Set does not have.
-func (s Set[T]) NewDiff(other Set[T]) Set[T] {
+func (s Set[T]) NewDiff(other Setter[T]) Set[T] {
+	if other == nil {
+		return s.Copy().(Set[T])
+	}
 	newSet := NewSet[T]()
 	for key := range s {
 		if !other.Has(key) {
@@ -181,24 +204,24 @@ func (s Set[T]) NewDiff(other Set[T]) Set[T] {
 }
 
 // Union unions the original Set with another Set
-func (s Set[T]) Union(other Set[T]) Set[T] {
+func (s Set[T]) Union(other Setter[T]) Setter[T] {
 	if other == nil || other.Size() == 0 {
 		return s
 	}
-	for key := range other {
+	for _, key := range other.ToSlice() {
 		s.Add(key)
 	}
 	return s
 }
 
 // NewUnion returns a new Set with the union of the original Set and another Set
-func (s Set[T]) NewUnion(other Set[T]) Set[T] {
+func (s Set[T]) NewUnion(other Setter[T]) Set[T] {
 	newSet := s.Copy()
-	return newSet.Union(other)
+	return newSet.Union(other).(Set[T])
 }
 
 // Intersect intersects the original Set with another Set
-func (s Set[T]) Intersect(other Set[T]) Set[T] {
+func (s Set[T]) Intersect(other Setter[T]) Setter[T] {
 	if other == nil || other.Size() == 0 {
 		return s
 	}
@@ -212,7 +235,7 @@ func (s Set[T]) Intersect(other Set[T]) Set[T] {
 }
 
 // NewIntersect returns a new Set with the intersection of the original Set and another Set
-func (s Set[T]) NewIntersect(other Set[T]) Set[T] {
+func (s Set[T]) NewIntersect(other Setter[T]) Set[T] {
 	newSet := s.Copy()
-	return newSet.Intersect(other)
+	return newSet.Intersect(other).(Set[T])
 }
@@ -0,0 +1,122 @@
+package collections
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DefaultPowerSetSizeGuard is the default maximum Set size PowerSet will
+// compute a power set for; see PowerSet.
+const DefaultPowerSetSizeGuard = 20
+
+// SymmetricDifference mutates s to contain only the elements that are in
+// exactly one of s and other, not both. Returns s for chaining purposes.
+func (s Set[T]) SymmetricDifference(other Setter[T]) Setter[T] {
+	if other == nil || other.Size() == 0 {
+		return s
+	}
+
+	intersection := s.NewIntersect(other)
+
+	for _, key := range other.ToSlice() {
+		if !intersection.Has(key) {
+			s.Add(key)
+		}
+	}
+	for key := range s {
+		if intersection.Has(key) {
+			delete(s, key)
+		}
+	}
+
+	return s
+}
+
+// NewSymmetricDifference returns a new Set containing only the elements
+// that are in exactly one of s and other, not both.
+func (s Set[T]) NewSymmetricDifference(other Setter[T]) Set[T] {
+	newSet := s.Copy().(Set[T])
+	return newSet.SymmetricDifference(other).(Set[T])
+}
+
+// Equal returns true if s and other contain exactly the same elements.
+func (s Set[T]) Equal(other Setter[T]) bool {
+	if other == nil || s.Size() != other.Size() {
+		return false
+	}
+
+	for key := range s {
+		if !other.Has(key) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Pop removes and returns an arbitrary element from the Set.
+// Returns false if the Set is empty. Useful for worklist algorithms that
+// want to drain a Set one element at a time.
+func (s Set[T]) Pop() (T, bool) {
+	for key := range s {
+		delete(s, key)
+		return key, true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// CartesianProduct returns the Set of every Pair (a, b) where a is an
+// element of s and b is an element of other.
+//
+// This is a package-level function, not a method on Set, because Go
+// methods can't introduce type parameters beyond the receiver's own — a
+// method-shaped CartesianProduct[U] isn't expressible. This signature is
+// the confirmed, final shape for that reason, not a placeholder.
+func CartesianProduct[T, U comparable](s Set[T], other Set[U]) Set[Pair[T, U]] {
+	result := NewSet[Pair[T, U]]()
+	for a := range s {
+		for b := range other {
+			result.Add(Pair[T, U]{First: a, Second: b})
+		}
+	}
+	return result
+}
+
+// PowerSet returns every subset of s, including the empty Set and s
+// itself. It's O(2^n) in both time and space for a Set of size n, so it
+// refuses to run on a Set larger than sizeGuard elements (DefaultPowerSetSizeGuard
+// if sizeGuard is omitted), returning an error instead.
+//
+// It returns []Set[T] rather than Set[Set[T]]: Set[T] is backed by a Go
+// map, and maps aren't comparable, so Set[T] can never satisfy the
+// comparable constraint Set itself requires of its element type. This
+// signature is the confirmed, final shape for that reason, not a
+// placeholder pending a Set[Set[T]] migration.
+func (s Set[T]) PowerSet(sizeGuard ...int) ([]Set[T], error) {
+	guard := DefaultPowerSetSizeGuard
+	if len(sizeGuard) > 0 {
+		guard = sizeGuard[0]
+	}
+
+	if s.Size() > guard {
+		return nil, errors.New(fmt.Sprintf("collections: Set has %d elements, exceeds PowerSet size guard of %d", s.Size(), guard))
+	}
+
+	elements := s.ToSlice()
+	n := len(elements)
+	result := make([]Set[T], 0, 1<<n)
+
+	for mask := 0; mask < (1 << n); mask++ {
+		subset := NewSet[T]()
+		for i, el := range elements {
+			if mask&(1<<i) != 0 {
+				subset.Add(el)
+			}
+		}
+		result = append(result, subset)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,46 @@
+package collections
+
+// Setter is the common surface implemented by both Set and ConcurrentSet.
+// It lets callers write code (e.g. combinators, worklist algorithms) against
+// either the plain map-backed Set or the lock-guarded ConcurrentSet without
+// caring which one they were handed.
+type Setter[T comparable] interface {
+	// Add adds an element to the Set. Returns the Setter itself for chaining.
+	Add(element T) Setter[T]
+
+	// Has returns true if the element is in the Set.
+	Has(element T) bool
+
+	// Remove removes an element from the Set.
+	// Returns an error if the element is not in the Set.
+	Remove(element T) error
+
+	// Size gets the size of the Set.
+	Size() int
+
+	// ToSlice returns a slice of all elements in the Set.
+	// This is NOT ordered as the Set does not guarantee order.
+	ToSlice() []T
+
+	// Union unions the original Set with another Set.
+	Union(other Setter[T]) Setter[T]
+
+	// Intersect intersects the original Set with another Set.
+	Intersect(other Setter[T]) Setter[T]
+
+	// Diff removes all the elements in the Set that the other Set has too.
+	Diff(other Setter[T]) Setter[T]
+
+	// IsSubSetOf returns true if the Set is a subSet of the other Set.
+	IsSubSetOf(other Setter[T]) bool
+
+	// IsSuperSetOf returns true if the Set is a super Set of the other Set.
+	IsSuperSetOf(other Setter[T]) bool
+
+	// Copy copies the Set to a new Set of the same concrete type.
+	Copy() Setter[T]
+
+	// Clear removes all elements in the Set.
+	// Returns the Setter itself for chaining.
+	Clear() Setter[T]
+}
@@ -1,5 +1,12 @@
+// Package gslice provides generic functional helpers over slices.
+//
+// This is the single home for slice helpers; the slices package re-exports
+// only Has and AllThatSatisfies for backward compatibility, so prefer
+// importing gslice directly.
 package gslice
 
+import "github.com/cyiafn/letsgo/collections"
+
 func Map[T, U any](s []T, f func(T) U) []U {
 	r := make([]U, len(s))
 	for i, v := range s {
@@ -20,3 +27,117 @@ func Filter[T any](s []T, f func(T) bool) []T {
 	return r
 }
 
+// Has returns true if the given slice contains the given element, otherwise it returns false.
+func Has[T comparable](s []T, a T) bool {
+	for _, v := range s {
+		if v == a {
+			return true
+		}
+	}
+	return false
+}
+
+// AllThatSatisfies returns a new slice that contains all elements that satisfy the given function.
+func AllThatSatisfies[T any](s []T, f func(T) bool) []T {
+	if s == nil || len(s) == 0 {
+		return make([]T, 0)
+	}
+	result := make([]T, 0, len(s))
+	for _, v := range s {
+		if f(v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Reduce folds s into a single value, starting from init and combining it
+// with each element of s in turn via f.
+func Reduce[T, U any](s []T, init U, f func(U, T) U) U {
+	acc := init
+	for _, v := range s {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// FlatMap applies f to every element of s and flattens the results into a
+// single slice.
+func FlatMap[T, U any](s []T, f func(T) []U) []U {
+	r := make([]U, 0, len(s))
+	for _, v := range s {
+		r = append(r, f(v)...)
+	}
+	return r
+}
+
+// GroupBy buckets the elements of s by the key that key returns for each
+// element.
+func GroupBy[T any, K comparable](s []T, key func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, v := range s {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
+
+// Partition splits s into the elements that satisfy pred and the elements
+// that don't, preserving the relative order within each.
+func Partition[T any](s []T, pred func(T) bool) (yes, no []T) {
+	for _, v := range s {
+		if pred(v) {
+			yes = append(yes, v)
+		} else {
+			no = append(no, v)
+		}
+	}
+	return yes, no
+}
+
+// Chunk splits s into consecutive subslices of at most size elements each.
+// Panics if size <= 0.
+func Chunk[T any](s []T, size int) [][]T {
+	if size <= 0 {
+		panic("gslice: size must be > 0")
+	}
+
+	chunks := make([][]T, 0, (len(s)+size-1)/size)
+	for size < len(s) {
+		s, chunks = s[size:], append(chunks, s[:size:size])
+	}
+	if len(s) > 0 {
+		chunks = append(chunks, s)
+	}
+	return chunks
+}
+
+// Unique returns a new slice with duplicate elements removed, preserving
+// the order of first occurrence.
+func Unique[T comparable](s []T) []T {
+	seen := collections.NewSet[T]()
+	result := make([]T, 0, len(s))
+	for _, v := range s {
+		if seen.Has(v) {
+			continue
+		}
+		seen.Add(v)
+		result = append(result, v)
+	}
+	return result
+}
+
+// Zip pairs up elements of a and b by index, stopping at the shorter of
+// the two slices.
+func Zip[A, B any](a []A, b []B) []collections.Pair[A, B] {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+
+	result := make([]collections.Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		result[i] = collections.Pair[A, B]{First: a[i], Second: b[i]}
+	}
+	return result
+}
@@ -1,26 +1,16 @@
-// Package slices provides useful collection data structures for Go.
+// Package slices re-exports gslice's Has and AllThatSatisfies under the older
+// import path that predates it, so existing callers keep compiling.
+// Prefer importing gslice directly; this package just forwards to it.
 package slices
 
+import "github.com/cyiafn/letsgo/gslice"
+
 // Has returns true if the given slice contains the given element, otherwise it returns false.
 func Has[T comparable](s []T, a T) bool {
-	for _, v := range s {
-		if v == a {
-			return true
-		}
-	}
-	return false
+	return gslice.Has(s, a)
 }
 
 // AllThatSatisfies returns a new slice that contains all elements that satisfy the given function.
 func AllThatSatisfies[T any](s []T, f func(T) bool) []T {
-	if s == nil || len(s) == 0 {
-		return make([]T, 0)
-	}
-	result := make([]T, 0, len(s))
-	for _, v := range s {
-		if f(v) {
-			result = append(result, v)
-		}
-	}
-	return result
+	return gslice.AllThatSatisfies(s, f)
 }